@@ -0,0 +1,11 @@
+package apperror
+
+// Error is the stable JSON shape every error response is rendered as, so
+// clients can rely on {code, message, request_id, trace_id} regardless of
+// which handler or middleware produced it.
+type Error struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id,omitempty"`
+	TraceID   string `json:"trace_id,omitempty"`
+}
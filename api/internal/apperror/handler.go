@@ -0,0 +1,42 @@
+package apperror
+
+import (
+	"errors"
+
+	sentry "github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/middleware"
+)
+
+// Handler replaces Fiber's default error handling. Every error, whether
+// returned by a handler or raised by the recover middleware after a
+// panic, is rendered as the stable {code, message, request_id, trace_id}
+// schema, and 5xx responses are additionally reported to Sentry.
+func Handler() fiber.ErrorHandler {
+	return func(c *fiber.Ctx, err error) error {
+		code := fiber.StatusInternalServerError
+		message := "Internal Server Error"
+
+		var fiberErr *fiber.Error
+		if errors.As(err, &fiberErr) {
+			code = fiberErr.Code
+			message = fiberErr.Message
+		}
+
+		if code >= fiber.StatusInternalServerError {
+			sentry.CaptureException(err)
+		}
+
+		requestID, _ := c.Locals(middleware.RequestIDLocalsKey).(string)
+		spanCtx := trace.SpanFromContext(c.UserContext()).SpanContext()
+
+		return c.Status(code).JSON(Error{
+			Code:      code,
+			Message:   message,
+			RequestID: requestID,
+			TraceID:   spanCtx.TraceID().String(),
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config holds server configuration sourced from the environment, with
+// sensible local-dev defaults so the API runs without any setup.
+type Config struct {
+	Port      string
+	DBPath    string
+	JWTSecret string
+
+	ServiceName      string
+	OtelExporterOTLP string
+	OtelSampleRatio  float64
+
+	LogEncoding string // "json" or "console"
+	LogLevel    string
+
+	SentryDSN        string
+	SentrySampleRate float64
+
+	CacheTTL time.Duration
+}
+
+// Load reads configuration from the environment, falling back to
+// development defaults for anything unset.
+func Load() Config {
+	return Config{
+		Port:      getenv("PORT", "8080"),
+		DBPath:    getenv("DB_PATH", "data/hackerfolio.db"),
+		JWTSecret: getenv("JWT_SECRET", "dev-secret-change-me"),
+
+		ServiceName:      getenv("OTEL_SERVICE_NAME", "hackerfolio-api"),
+		OtelExporterOTLP: getenv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
+		OtelSampleRatio:  getenvFloat("OTEL_SAMPLE_RATIO", 1.0),
+
+		LogEncoding: getenv("LOG_ENCODING", "json"),
+		LogLevel:    getenv("LOG_LEVEL", "info"),
+
+		SentryDSN:        getenv("SENTRY_DSN", ""),
+		SentrySampleRate: getenvFloat("SENTRY_SAMPLE_RATE", 1.0),
+
+		CacheTTL: getenvDuration("CACHE_TTL", time.Minute),
+	}
+}
+
+func getenv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getenvFloat(key string, fallback float64) float64 {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return fallback
+	}
+	return f
+}
+
+func getenvDuration(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fallback
+	}
+	return d
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/middleware"
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/store"
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/terminal"
+)
+
+// Cache keys mirror the CachedReadOnly middleware's own key format
+// (path + "_" + method) so admin writes can purge the exact entry they
+// invalidate.
+var (
+	cacheKeyProfile  = middleware.PurgeKey("/api/profile")
+	cacheKeyProjects = middleware.PurgeKey("/api/projects")
+)
+
+// Handlers wires the HTTP layer to the repository interfaces it needs.
+// Depending on interfaces rather than *store.Store keeps handlers testable
+// with in-memory fakes.
+type Handlers struct {
+	Profiles store.ProfileRepo
+	Projects store.ProjectRepo
+	Skills   store.SkillRepo
+	Terminal store.TerminalRepo
+
+	log   *zap.Logger
+	exec  *terminal.Executor
+	hub   *terminal.Hub
+	cache fiber.Storage
+}
+
+// New builds a Handlers bound to the given repositories, logger, and
+// response cache store.
+func New(profiles store.ProfileRepo, projects store.ProjectRepo, skills store.SkillRepo, terminalRepo store.TerminalRepo, log *zap.Logger, cache fiber.Storage) *Handlers {
+	return &Handlers{
+		Profiles: profiles,
+		Projects: projects,
+		Skills:   skills,
+		Terminal: terminalRepo,
+
+		log:   log,
+		exec:  terminal.NewExecutor(projects, skills),
+		hub:   terminal.NewHub(),
+		cache: cache,
+	}
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/store"
+)
+
+// GetProfile handles GET /api/profile.
+func (h *Handlers) GetProfile(c *fiber.Ctx) error {
+	profile, err := h.Profiles.Get()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to load profile"})
+	}
+	return c.JSON(profile)
+}
+
+// UpdateProfile handles PUT /api/profile. It is admin-only.
+func (h *Handlers) UpdateProfile(c *fiber.Ctx) error {
+	var p store.Profile
+	if err := c.BodyParser(&p); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	updated, err := h.Profiles.Upsert(p)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to save profile"})
+	}
+
+	_ = h.cache.Delete(cacheKeyProfile)
+	return c.JSON(updated)
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/store"
+)
+
+// ListProjects handles GET /api/projects.
+func (h *Handlers) ListProjects(c *fiber.Ctx) error {
+	projects, err := h.Projects.List()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to load projects"})
+	}
+	return c.JSON(projects)
+}
+
+// CreateProject handles POST /api/projects. It is admin-only.
+func (h *Handlers) CreateProject(c *fiber.Ctx) error {
+	var p store.Project
+	if err := c.BodyParser(&p); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	created, err := h.Projects.Create(p)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to create project"})
+	}
+
+	_ = h.cache.Delete(cacheKeyProjects)
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// UpdateProject handles PUT /api/projects/:id. It is admin-only.
+func (h *Handlers) UpdateProject(c *fiber.Ctx) error {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid project id"})
+	}
+
+	var p store.Project
+	if err := c.BodyParser(&p); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	p.ID = id
+
+	updated, err := h.Projects.Update(p)
+	if errors.Is(err, sql.ErrNoRows) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Project not found"})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update project"})
+	}
+
+	_ = h.cache.Delete(cacheKeyProjects)
+	return c.JSON(updated)
+}
+
+// DeleteProject handles DELETE /api/projects/:id. It is admin-only.
+func (h *Handlers) DeleteProject(c *fiber.Ctx) error {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid project id"})
+	}
+
+	if err := h.Projects.Delete(id); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to delete project"})
+	}
+
+	_ = h.cache.Delete(cacheKeyProjects)
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/middleware"
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/store"
+)
+
+// LogTerminalCommand handles POST /api/terminal/log.
+func (h *Handlers) LogTerminalCommand(c *fiber.Ctx) error {
+	var body struct {
+		Command   string    `json:"command"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+
+	spanCtx := trace.SpanFromContext(c.UserContext()).SpanContext()
+	requestID, _ := c.Locals(middleware.RequestIDLocalsKey).(string)
+	h.log.Info("terminal command",
+		zap.String("command", body.Command),
+		zap.Time("timestamp", body.Timestamp),
+		zap.String("trace_id", spanCtx.TraceID().String()),
+		zap.String("span_id", spanCtx.SpanID().String()),
+		zap.String("request_id", requestID),
+	)
+
+	if err := h.Terminal.Log(store.TerminalCommand{Command: body.Command, Timestamp: body.Timestamp}); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to log command"})
+	}
+	return c.JSON(fiber.Map{"logged": true})
+}
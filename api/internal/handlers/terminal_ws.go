@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/middleware"
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/store"
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/terminal"
+)
+
+const (
+	wsRateBurst   = 5   // commands
+	wsRateRefill  = 2.0 // commands per second
+	wsPingEvery   = 30 * time.Second
+	wsPongTimeout = 60 * time.Second
+)
+
+// safeConn serializes writes to a *websocket.Conn. The read loop and the
+// relayActivity goroutine both write to the same connection, and
+// fasthttp/websocket panics on concurrent writes, so every write site must
+// go through here.
+type safeConn struct {
+	conn *websocket.Conn
+	mu   sync.Mutex
+}
+
+func (s *safeConn) WriteMessage(messageType int, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.WriteMessage(messageType, data)
+}
+
+// RequireWebSocketUpgrade rejects any request to a websocket route that
+// isn't actually an upgrade, before the websocket.New handler takes over.
+func RequireWebSocketUpgrade(c *fiber.Ctx) error {
+	if websocket.IsWebSocketUpgrade(c) {
+		return c.Next()
+	}
+	return fiber.ErrUpgradeRequired
+}
+
+// TerminalWS handles GET /ws/terminal: a live, rate-limited terminal session
+// that executes allow-listed commands and broadcasts an anonymized
+// activity feed to every other connected visitor.
+func (h *Handlers) TerminalWS() fiber.Handler {
+	return websocket.New(func(conn *websocket.Conn) {
+		requestID, _ := conn.Locals(middleware.RequestIDLocalsKey).(string)
+		sc := &safeConn{conn: conn}
+		limiter := terminal.NewLimiter(wsRateBurst, wsRateRefill)
+		feed := h.hub.Join()
+		defer h.hub.Leave(feed)
+
+		done := make(chan struct{})
+		go h.relayActivity(sc, feed, done)
+
+		conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+		})
+
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				close(done)
+				return
+			}
+
+			command := strings.TrimSpace(string(msg))
+			if command == "" {
+				continue
+			}
+			if !limiter.Allow() {
+				h.writeLine(sc, "rate limit exceeded, slow down")
+				continue
+			}
+
+			h.runCommand(sc, command, requestID)
+		}
+	})
+}
+
+// runCommand executes a command, streams its output token-by-token, logs
+// it, and broadcasts an anonymized notice to everyone else connected.
+func (h *Handlers) runCommand(conn *safeConn, command, requestID string) {
+	output, err := h.exec.Execute(command)
+	if err != nil {
+		h.writeLine(conn, fmt.Sprintf("error: %v", err))
+		return
+	}
+
+	for _, token := range strings.Fields(output) {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(token+" ")); err != nil {
+			return
+		}
+	}
+	conn.WriteMessage(websocket.TextMessage, []byte("\n"))
+
+	h.log.Info("ws terminal command",
+		zap.String("command", command),
+		zap.String("request_id", requestID),
+	)
+
+	if err := h.Terminal.Log(store.TerminalCommand{Command: command, Timestamp: time.Now()}); err != nil {
+		h.writeLine(conn, "warning: failed to record command")
+	}
+
+	h.hub.Broadcast(fmt.Sprintf("someone just ran %q", firstWord(command)), nil)
+}
+
+// relayActivity forwards the shared activity feed and periodic pings to
+// this connection until done is closed.
+func (h *Handlers) relayActivity(conn *safeConn, feed chan string, done chan struct{}) {
+	ticker := time.NewTicker(wsPingEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case msg := <-feed:
+			if err := conn.WriteMessage(websocket.TextMessage, []byte("* "+msg)); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+func (h *Handlers) writeLine(conn *safeConn, line string) {
+	_ = conn.WriteMessage(websocket.TextMessage, []byte(line+"\n"))
+}
+
+func firstWord(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return s
+	}
+	return fields[0]
+}
@@ -0,0 +1,31 @@
+package logging
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Config selects the zap encoder and minimum level.
+type Config struct {
+	Encoding string // "json" or "console"
+	Level    string // zap level name, e.g. "info", "debug"
+}
+
+// New builds a zap.Logger producing structured logs (level, ts, and
+// whatever fields callers attach) in either JSON or console form.
+func New(cfg Config) (*zap.Logger, error) {
+	level, err := zapcore.ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, fmt.Errorf("parse log level %q: %w", cfg.Level, err)
+	}
+
+	zcfg := zap.NewProductionConfig()
+	zcfg.Encoding = cfg.Encoding
+	zcfg.Level = zap.NewAtomicLevelAt(level)
+	zcfg.EncoderConfig.TimeKey = "ts"
+	zcfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	return zcfg.Build()
+}
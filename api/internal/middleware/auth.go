@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	jwtware "github.com/gofiber/contrib/jwt"
+	"github.com/gofiber/fiber/v2"
+)
+
+// AdminAuth protects the admin CRUD routes with an HS256 JWT. Tokens are
+// issued out-of-band (e.g. a one-off script) since the portfolio has a
+// single owner and no sign-up flow.
+func AdminAuth(secret string) fiber.Handler {
+	return jwtware.New(jwtware.Config{
+		SigningKey: jwtware.SigningKey{Key: []byte(secret)},
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Unauthorized"})
+		},
+	})
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cache"
+	"github.com/gofiber/fiber/v2/middleware/etag"
+	"github.com/gofiber/storage/memory"
+)
+
+// ETag generates a real ETag (and serves 304s for matching If-None-Match
+// requests) from the final response body, cached or not. It must be
+// registered ahead of CachedReadOnly in the handler chain so it sees the
+// response CachedReadOnly produces.
+func ETag() fiber.Handler {
+	return etag.New()
+}
+
+// NewCacheStore builds the in-memory store backing CachedReadOnly. Handlers
+// that mutate portfolio content hold the same store so they can purge
+// stale entries on write, via PurgeKey.
+func NewCacheStore() fiber.Storage {
+	return memory.New()
+}
+
+// PurgeKey reproduces the cache middleware's own key format
+// (KeyGenerator(c) + "_" + c.Method()) for a known GET route path, so
+// callers can purge exactly the entry CachedReadOnly wrote.
+func PurgeKey(path string) string {
+	return path + "_" + fiber.MethodGet
+}
+
+// CachedReadOnly caches GET responses for ttl, keyed by path, emits
+// Cache-Control, and marks every response Vary: Accept-Encoding so caches
+// downstream of compress don't serve a gzip body to a client that didn't
+// ask for one. Pair it with ETag, registered ahead of it, for conditional
+// requests.
+func CachedReadOnly(store fiber.Storage, ttl time.Duration) fiber.Handler {
+	cached := cache.New(cache.Config{
+		Expiration:   ttl,
+		CacheHeader:  "X-Cache",
+		CacheControl: true,
+		Methods:      []string{fiber.MethodGet},
+		Storage:      store,
+		KeyGenerator: func(c *fiber.Ctx) string { return c.Path() },
+	})
+
+	return func(c *fiber.Ctx) error {
+		c.Set(fiber.HeaderVary, fiber.HeaderAcceptEncoding)
+		return cached(c)
+	}
+}
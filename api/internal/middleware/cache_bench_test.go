@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/compress"
+)
+
+// payload approximates a real /api/projects response body, large enough
+// for compress to have something to do.
+var payload = strings.Repeat(`{"id":1,"name":"Portfolio","description":"A vintage CRT-inspired portfolio","stack":["React","TypeScript","Tailwind"]},`, 50)
+
+func benchApp(useCache, useCompress bool) *fiber.App {
+	app := fiber.New()
+	if useCompress {
+		app.Use(compress.New(compress.Config{Level: compress.LevelBestSpeed}))
+	}
+
+	handler := func(c *fiber.Ctx) error { return c.SendString(payload) }
+	if useCache {
+		app.Get("/bench", CachedReadOnly(NewCacheStore(), time.Minute), handler)
+	} else {
+		app.Get("/bench", handler)
+	}
+	return app
+}
+
+func runBench(b *testing.B, app *fiber.App) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		req, err := http.NewRequest(http.MethodGet, "/bench", nil)
+		if err != nil {
+			b.Fatalf("http.NewRequest: %v", err)
+		}
+		req.Header.Set(fiber.HeaderAcceptEncoding, "gzip")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			b.Fatalf("app.Test: %v", err)
+		}
+		if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+			b.Fatalf("drain body: %v", err)
+		}
+		resp.Body.Close()
+	}
+}
+
+// BenchmarkUncached is the baseline: every request re-serializes the
+// response and re-compresses it from scratch.
+func BenchmarkUncached(b *testing.B) {
+	runBench(b, benchApp(false, false))
+}
+
+// BenchmarkCompressOnly isolates compress's cost with no caching.
+func BenchmarkCompressOnly(b *testing.B) {
+	runBench(b, benchApp(false, true))
+}
+
+// BenchmarkCachedAndCompressed reflects the real /api/profile and
+// /api/projects route wiring: cache avoids redoing handler work, and
+// compress still runs per request to honor Accept-Encoding.
+func BenchmarkCachedAndCompressed(b *testing.B) {
+	runBench(b, benchApp(true, true))
+}
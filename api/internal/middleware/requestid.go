@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/oklog/ulid/v2"
+)
+
+// RequestIDLocalsKey is the c.Locals key the request ID is stored under,
+// matching the requestid middleware's default context key.
+const RequestIDLocalsKey = "requestid"
+
+// ulid.Monotonic's entropy source isn't safe for concurrent use, and every
+// request hits the generator concurrently.
+var (
+	entropyMu   sync.Mutex
+	entropyPool = ulid.Monotonic(rand.New(rand.NewSource(time.Now().UnixNano())), 0)
+)
+
+func newULID() string {
+	entropyMu.Lock()
+	defer entropyMu.Unlock()
+	return ulid.MustNew(ulid.Timestamp(time.Now()), entropyPool).String()
+}
+
+// RequestID generates a ULID per request, stores it under RequestIDLocalsKey,
+// and echoes it back in the X-Request-ID response header so client and
+// server logs can be correlated.
+func RequestID() fiber.Handler {
+	return requestid.New(requestid.Config{
+		Generator: newULID,
+	})
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	sentry "github.com/getsentry/sentry-go"
+	sentryfiber "github.com/gofiber/contrib/fibersentry"
+	"github.com/gofiber/fiber/v2"
+)
+
+// SentryConfig configures panic/error reporting. DSN is empty in local
+// dev, which leaves reporting disabled.
+type SentryConfig struct {
+	DSN        string
+	SampleRate float64
+}
+
+// InitSentry initializes the global Sentry client. It is a no-op when DSN
+// is empty, so local dev never needs a real Sentry project.
+func InitSentry(cfg SentryConfig) error {
+	if cfg.DSN == "" {
+		return nil
+	}
+	return sentry.Init(sentry.ClientOptions{
+		Dsn:        cfg.DSN,
+		SampleRate: cfg.SampleRate,
+	})
+}
+
+// SentryRecover recovers panics, reports them to Sentry with the request
+// as context, and re-panics so the custom ErrorHandler still renders the
+// stable error schema.
+func SentryRecover() fiber.Handler {
+	return sentryfiber.New(sentryfiber.Config{
+		Repanic:         true,
+		WaitForDelivery: false,
+	})
+}
+
+// Breadcrumb records this request as a Sentry breadcrumb, so a panic or
+// 5xx a few handlers later shows the last N requests that led up to it.
+func Breadcrumb() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if hub := sentryfiber.GetHubFromContext(c); hub != nil {
+			hub.AddBreadcrumb(&sentry.Breadcrumb{
+				Category: "request",
+				Message:  c.Method() + " " + c.Path(),
+				Level:    sentry.LevelInfo,
+			}, nil)
+		}
+		return c.Next()
+	}
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	sentry "github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/recover"
+)
+
+// stubTransport captures events instead of sending them anywhere, so tests
+// can assert a panic was reported without touching the network.
+type stubTransport struct {
+	mu     sync.Mutex
+	events []*sentry.Event
+}
+
+func (t *stubTransport) Configure(sentry.ClientOptions) {}
+func (t *stubTransport) SendEvent(e *sentry.Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.events = append(t.events, e)
+}
+func (t *stubTransport) Flush(time.Duration) bool              { return true }
+func (t *stubTransport) FlushWithContext(context.Context) bool { return true }
+func (t *stubTransport) Close()                                {}
+func (t *stubTransport) captured() []*sentry.Event {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.events
+}
+
+func TestSentryRecoverReportsPanic(t *testing.T) {
+	transport := &stubTransport{}
+	if err := sentry.Init(sentry.ClientOptions{
+		Dsn:       "https://public@sentry.example.com/1",
+		Transport: transport,
+	}); err != nil {
+		t.Fatalf("sentry.Init: %v", err)
+	}
+
+	app := fiber.New(fiber.Config{ErrorHandler: func(c *fiber.Ctx, err error) error {
+		return c.Status(fiber.StatusInternalServerError).SendString("error")
+	}})
+	app.Use(recover.New())
+	app.Use(SentryRecover())
+	app.Get("/panic", func(c *fiber.Ctx) error {
+		panic("boom")
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "/panic", nil)
+	if err != nil {
+		t.Fatalf("http.NewRequest: %v", err)
+	}
+	if _, err := app.Test(req); err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+
+	if len(transport.captured()) == 0 {
+		t.Fatal("expected the panic to produce a captured Sentry event, got none")
+	}
+}
@@ -0,0 +1,38 @@
+package store
+
+// schema is applied idempotently on every startup. Sqlite migrations stay
+// this small and hand-rolled until the schema outgrows a single file.
+const schema = `
+CREATE TABLE IF NOT EXISTS profiles (
+	id       INTEGER PRIMARY KEY AUTOINCREMENT,
+	name     TEXT NOT NULL,
+	title    TEXT NOT NULL,
+	location TEXT NOT NULL,
+	status   TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS projects (
+	id          INTEGER PRIMARY KEY AUTOINCREMENT,
+	name        TEXT NOT NULL,
+	description TEXT NOT NULL,
+	stack       TEXT NOT NULL DEFAULT '[]',
+	featured    INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS skills (
+	id       INTEGER PRIMARY KEY AUTOINCREMENT,
+	name     TEXT NOT NULL,
+	category TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS terminal_commands (
+	id        INTEGER PRIMARY KEY AUTOINCREMENT,
+	command   TEXT NOT NULL,
+	timestamp DATETIME NOT NULL
+);
+`
+
+func (s *Store) migrate() error {
+	_, err := s.db.Exec(schema)
+	return err
+}
@@ -0,0 +1,37 @@
+package store
+
+import "time"
+
+// Profile is the site owner's portfolio summary shown on the landing page.
+type Profile struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Title    string `json:"title"`
+	Location string `json:"location"`
+	Status   string `json:"status"`
+}
+
+// Project is a single portfolio project entry.
+type Project struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Stack       []string `json:"stack"`
+	Featured    bool     `json:"featured"`
+}
+
+// Skill is a single skill/technology entry surfaced in the `skills`
+// terminal command and on the portfolio page.
+type Skill struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
+
+// TerminalCommand records a command executed against the interactive
+// terminal, for analytics and the live activity feed.
+type TerminalCommand struct {
+	ID        int64     `json:"id"`
+	Command   string    `json:"command"`
+	Timestamp time.Time `json:"timestamp"`
+}
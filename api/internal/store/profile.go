@@ -0,0 +1,56 @@
+package store
+
+import (
+	"database/sql"
+	"time"
+)
+
+// ProfileStore is the SQLite-backed ProfileRepo implementation.
+type ProfileStore struct {
+	db *sql.DB
+}
+
+// Get returns the site owner's profile. There is always exactly one row,
+// created by Seed on first run.
+func (r *ProfileStore) Get() (Profile, error) {
+	defer observeQuery("profile_get", time.Now())
+
+	var p Profile
+	row := r.db.QueryRow(`SELECT id, name, title, location, status FROM profiles ORDER BY id LIMIT 1`)
+	if err := row.Scan(&p.ID, &p.Name, &p.Title, &p.Location, &p.Status); err != nil {
+		return Profile{}, err
+	}
+	return p, nil
+}
+
+// Upsert replaces the single profile row's editable fields.
+func (r *ProfileStore) Upsert(p Profile) (Profile, error) {
+	defer observeQuery("profile_upsert", time.Now())
+
+	existing, err := r.Get()
+	if err == sql.ErrNoRows {
+		res, err := r.db.Exec(
+			`INSERT INTO profiles (name, title, location, status) VALUES (?, ?, ?, ?)`,
+			p.Name, p.Title, p.Location, p.Status,
+		)
+		if err != nil {
+			return Profile{}, err
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			return Profile{}, err
+		}
+		p.ID = id
+		return p, nil
+	}
+	if err != nil {
+		return Profile{}, err
+	}
+
+	p.ID = existing.ID
+	_, err = r.db.Exec(
+		`UPDATE profiles SET name = ?, title = ?, location = ?, status = ? WHERE id = ?`,
+		p.Name, p.Title, p.Location, p.Status, p.ID,
+	)
+	return p, err
+}
@@ -0,0 +1,103 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// ProjectStore is the SQLite-backed ProjectRepo implementation.
+type ProjectStore struct {
+	db *sql.DB
+}
+
+// List returns every project, most recently created first.
+func (r *ProjectStore) List() ([]Project, error) {
+	defer observeQuery("projects_list", time.Now())
+
+	rows, err := r.db.Query(`SELECT id, name, description, stack, featured FROM projects ORDER BY id DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var projects []Project
+	for rows.Next() {
+		var p Project
+		var stackJSON string
+		var featured int
+		if err := rows.Scan(&p.ID, &p.Name, &p.Description, &stackJSON, &featured); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(stackJSON), &p.Stack); err != nil {
+			return nil, err
+		}
+		p.Featured = featured != 0
+		projects = append(projects, p)
+	}
+	return projects, rows.Err()
+}
+
+// Create inserts a new project and returns it with its assigned ID.
+func (r *ProjectStore) Create(p Project) (Project, error) {
+	defer observeQuery("projects_create", time.Now())
+
+	stackJSON, err := json.Marshal(p.Stack)
+	if err != nil {
+		return Project{}, err
+	}
+	res, err := r.db.Exec(
+		`INSERT INTO projects (name, description, stack, featured) VALUES (?, ?, ?, ?)`,
+		p.Name, p.Description, string(stackJSON), boolToInt(p.Featured),
+	)
+	if err != nil {
+		return Project{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Project{}, err
+	}
+	p.ID = id
+	return p, nil
+}
+
+// Update replaces an existing project's fields in place. It returns
+// sql.ErrNoRows if id doesn't match an existing project.
+func (r *ProjectStore) Update(p Project) (Project, error) {
+	defer observeQuery("projects_update", time.Now())
+
+	stackJSON, err := json.Marshal(p.Stack)
+	if err != nil {
+		return Project{}, err
+	}
+	res, err := r.db.Exec(
+		`UPDATE projects SET name = ?, description = ?, stack = ?, featured = ? WHERE id = ?`,
+		p.Name, p.Description, string(stackJSON), boolToInt(p.Featured), p.ID,
+	)
+	if err != nil {
+		return Project{}, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return Project{}, err
+	}
+	if affected == 0 {
+		return Project{}, sql.ErrNoRows
+	}
+	return p, nil
+}
+
+// Delete removes a project by ID.
+func (r *ProjectStore) Delete(id int64) error {
+	defer observeQuery("projects_delete", time.Now())
+
+	_, err := r.db.Exec(`DELETE FROM projects WHERE id = ?`, id)
+	return err
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,40 @@
+package store
+
+// Seed populates an empty database with the original portfolio content, so
+// a fresh deploy looks identical to the previous hard-coded responses. It
+// is a no-op once a profile row already exists.
+func (s *Store) Seed() error {
+	if _, err := s.Profiles.Get(); err == nil {
+		return nil
+	}
+
+	if _, err := s.Profiles.Upsert(Profile{
+		Name:     "Tulio Cunha",
+		Title:    "Full-stack Developer",
+		Location: "Remote",
+		Status:   "Available for projects",
+	}); err != nil {
+		return err
+	}
+
+	if _, err := s.Projects.Create(Project{
+		Name:        "Terminal Portfolio",
+		Description: "A vintage CRT-inspired portfolio website with interactive terminal interface.",
+		Stack:       []string{"React", "TypeScript", "Tailwind"},
+		Featured:    true,
+	}); err != nil {
+		return err
+	}
+
+	for _, sk := range []Skill{
+		{Name: "Go", Category: "Backend"},
+		{Name: "TypeScript", Category: "Frontend"},
+		{Name: "React", Category: "Frontend"},
+		{Name: "SQLite", Category: "Database"},
+	} {
+		if _, err := s.Skills.Create(sk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
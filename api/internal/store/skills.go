@@ -0,0 +1,48 @@
+package store
+
+import (
+	"database/sql"
+	"time"
+)
+
+// SkillStore is the SQLite-backed SkillRepo implementation.
+type SkillStore struct {
+	db *sql.DB
+}
+
+// List returns every skill entry, grouped implicitly by category order.
+func (r *SkillStore) List() ([]Skill, error) {
+	defer observeQuery("skills_list", time.Now())
+
+	rows, err := r.db.Query(`SELECT id, name, category FROM skills ORDER BY category, name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var skills []Skill
+	for rows.Next() {
+		var sk Skill
+		if err := rows.Scan(&sk.ID, &sk.Name, &sk.Category); err != nil {
+			return nil, err
+		}
+		skills = append(skills, sk)
+	}
+	return skills, rows.Err()
+}
+
+// Create inserts a new skill and returns it with its assigned ID.
+func (r *SkillStore) Create(sk Skill) (Skill, error) {
+	defer observeQuery("skills_create", time.Now())
+
+	res, err := r.db.Exec(`INSERT INTO skills (name, category) VALUES (?, ?)`, sk.Name, sk.Category)
+	if err != nil {
+		return Skill{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Skill{}, err
+	}
+	sk.ID = id
+	return sk, nil
+}
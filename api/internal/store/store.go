@@ -0,0 +1,74 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "modernc.org/sqlite"
+)
+
+// ProfileRepo manages the single site-owner profile record.
+type ProfileRepo interface {
+	Get() (Profile, error)
+	Upsert(p Profile) (Profile, error)
+}
+
+// ProjectRepo manages portfolio project entries.
+type ProjectRepo interface {
+	List() ([]Project, error)
+	Create(p Project) (Project, error)
+	Update(p Project) (Project, error)
+	Delete(id int64) error
+}
+
+// SkillRepo manages skill/technology entries.
+type SkillRepo interface {
+	List() ([]Skill, error)
+	Create(sk Skill) (Skill, error)
+}
+
+// TerminalRepo records executed terminal commands.
+type TerminalRepo interface {
+	Log(cmd TerminalCommand) error
+}
+
+// Store owns the SQLite connection and exposes one repository per table.
+// Handlers depend on the repo interfaces above, not on the concrete repo
+// types, so they can be faked in tests without a database.
+type Store struct {
+	db *sql.DB
+
+	Profiles *ProfileStore
+	Projects *ProjectStore
+	Skills   *SkillStore
+	Terminal *TerminalStore
+}
+
+// New opens the SQLite database at path, applies migrations, and returns a
+// ready-to-use Store. Passing ":memory:" is supported for tests.
+func New(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("open db: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("ping db: %w", err)
+	}
+
+	s := &Store{
+		db:       db,
+		Profiles: &ProfileStore{db: db},
+		Projects: &ProjectStore{db: db},
+		Skills:   &SkillStore{db: db},
+		Terminal: &TerminalStore{db: db},
+	}
+	if err := s.migrate(); err != nil {
+		return nil, fmt.Errorf("migrate db: %w", err)
+	}
+	return s, nil
+}
+
+// Close releases the underlying database handle.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	s, err := New(":memory:")
+	if err != nil {
+		t.Fatalf("store.New: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestProfileUpsertCreatesThenUpdates(t *testing.T) {
+	s := newTestStore(t)
+
+	if _, err := s.Profiles.Get(); err == nil {
+		t.Fatal("expected Get on an empty store to return an error")
+	}
+
+	created, err := s.Profiles.Upsert(Profile{Name: "Ada", Title: "Engineer", Location: "Remote", Status: "Available"})
+	if err != nil {
+		t.Fatalf("Upsert (create): %v", err)
+	}
+	if created.ID == 0 {
+		t.Fatal("expected the created profile to have a non-zero ID")
+	}
+
+	updated, err := s.Profiles.Upsert(Profile{Name: "Ada Lovelace", Title: "Engineer", Location: "Remote", Status: "Busy"})
+	if err != nil {
+		t.Fatalf("Upsert (update): %v", err)
+	}
+	if updated.ID != created.ID {
+		t.Fatalf("expected Upsert to reuse the existing row ID %d, got %d", created.ID, updated.ID)
+	}
+
+	got, err := s.Profiles.Get()
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Name != "Ada Lovelace" || got.Status != "Busy" {
+		t.Fatalf("Get returned stale data: %+v", got)
+	}
+}
+
+func TestProjectCreateListUpdateDelete(t *testing.T) {
+	s := newTestStore(t)
+
+	created, err := s.Projects.Create(Project{Name: "Portfolio", Description: "desc", Stack: []string{"Go"}, Featured: true})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	projects, err := s.Projects.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(projects) != 1 || projects[0].Name != "Portfolio" || len(projects[0].Stack) != 1 {
+		t.Fatalf("List returned unexpected projects: %+v", projects)
+	}
+
+	created.Description = "updated desc"
+	if _, err := s.Projects.Update(created); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	projects, err = s.Projects.List()
+	if err != nil {
+		t.Fatalf("List after update: %v", err)
+	}
+	if projects[0].Description != "updated desc" {
+		t.Fatalf("expected updated description, got %q", projects[0].Description)
+	}
+
+	if err := s.Projects.Delete(created.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	projects, err = s.Projects.List()
+	if err != nil {
+		t.Fatalf("List after delete: %v", err)
+	}
+	if len(projects) != 0 {
+		t.Fatalf("expected no projects after delete, got %d", len(projects))
+	}
+}
+
+func TestSkillCreateAndList(t *testing.T) {
+	s := newTestStore(t)
+
+	if _, err := s.Skills.Create(Skill{Name: "Go", Category: "Backend"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	skills, err := s.Skills.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(skills) != 1 || skills[0].Name != "Go" {
+		t.Fatalf("List returned unexpected skills: %+v", skills)
+	}
+}
+
+func TestTerminalLog(t *testing.T) {
+	s := newTestStore(t)
+
+	if err := s.Terminal.Log(TerminalCommand{Command: "whoami", Timestamp: time.Now()}); err != nil {
+		t.Fatalf("Log: %v", err)
+	}
+}
+
+func TestSeedPopulatesProfileProjectsAndSkills(t *testing.T) {
+	s := newTestStore(t)
+
+	if err := s.Seed(); err != nil {
+		t.Fatalf("Seed: %v", err)
+	}
+
+	if _, err := s.Profiles.Get(); err != nil {
+		t.Fatalf("expected Seed to create a profile: %v", err)
+	}
+
+	projects, err := s.Projects.List()
+	if err != nil {
+		t.Fatalf("List projects: %v", err)
+	}
+	if len(projects) == 0 {
+		t.Fatal("expected Seed to create at least one project")
+	}
+
+	skills, err := s.Skills.List()
+	if err != nil {
+		t.Fatalf("List skills: %v", err)
+	}
+	if len(skills) == 0 {
+		t.Fatal("expected Seed to create at least one skill")
+	}
+
+	if err := s.Seed(); err != nil {
+		t.Fatalf("second Seed call should be a no-op, got error: %v", err)
+	}
+	projects, err = s.Projects.List()
+	if err != nil {
+		t.Fatalf("List projects after re-seed: %v", err)
+	}
+	if len(projects) != 1 {
+		t.Fatalf("expected Seed to stay a no-op on a populated store, got %d projects", len(projects))
+	}
+}
@@ -0,0 +1,47 @@
+package store
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/telemetry"
+)
+
+// TerminalStore is the SQLite-backed TerminalRepo implementation.
+type TerminalStore struct {
+	db *sql.DB
+}
+
+// terminalCommandVerbs mirrors the allow-listed verbs in
+// internal/terminal.Executor. Log comes from both the authenticated
+// websocket session and the unauthenticated POST /api/terminal/log
+// endpoint, so the command text itself is visitor-controlled and must
+// never be used as a Prometheus label directly — every distinct string
+// would mint a new time series.
+var terminalCommandVerbs = map[string]bool{
+	"help": true, "whoami": true, "contact": true, "resume": true,
+	"projects": true, "skills": true, "theme": true, "cat": true,
+}
+
+// terminalCommandLabel reduces a command line to a bounded metric label:
+// its verb if it's one of the allow-listed commands, or "other" otherwise.
+func terminalCommandLabel(command string) string {
+	verb, _, _ := strings.Cut(strings.TrimSpace(command), " ")
+	if terminalCommandVerbs[verb] {
+		return verb
+	}
+	return "other"
+}
+
+// Log records a single executed terminal command.
+func (r *TerminalStore) Log(cmd TerminalCommand) error {
+	defer observeQuery("terminal_log", time.Now())
+	telemetry.TerminalCommandsTotal.WithLabelValues(terminalCommandLabel(cmd.Command)).Inc()
+
+	_, err := r.db.Exec(
+		`INSERT INTO terminal_commands (command, timestamp) VALUES (?, ?)`,
+		cmd.Command, cmd.Timestamp,
+	)
+	return err
+}
@@ -0,0 +1,13 @@
+package store
+
+import (
+	"time"
+
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/telemetry"
+)
+
+// observeQuery records how long a named query took in the db_query_duration
+// histogram. Call with defer at the top of a repo method.
+func observeQuery(query string, start time.Time) {
+	telemetry.DBQueryDuration.WithLabelValues(query).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,20 @@
+package telemetry
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// DBQueryDuration tracks how long each named store query takes.
+var DBQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name: "hackerfolio_db_query_duration_seconds",
+	Help: "Duration of SQLite store queries, by query name.",
+}, []string{"query"})
+
+// TerminalCommandsTotal counts commands logged through the terminal
+// subsystem, by command name.
+var TerminalCommandsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "hackerfolio_terminal_commands_total",
+	Help: "Total terminal commands executed, by command name.",
+}, []string{"command"})
+
+func init() {
+	prometheus.MustRegister(DBQueryDuration, TerminalCommandsTotal)
+}
@@ -0,0 +1,15 @@
+package telemetry
+
+import (
+	fiberprometheus "github.com/ansrivas/fiberprometheus/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+// MountMetrics registers the standard Fiber request metrics (latency,
+// in-flight requests, status codes) and exposes them, alongside the custom
+// collectors above, at GET /metrics.
+func MountMetrics(app *fiber.App, serviceName string) {
+	prom := fiberprometheus.New(serviceName)
+	prom.RegisterAt(app, "/metrics")
+	app.Use(prom.Middleware)
+}
@@ -0,0 +1,43 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// TracingConfig configures the OTLP exporter used to ship spans.
+type TracingConfig struct {
+	ServiceName    string
+	ExporterOTLP   string // host:port of the OTLP/gRPC collector
+	SampleFraction float64
+}
+
+// InitTracer wires up a global TracerProvider that exports spans over
+// OTLP/gRPC and returns a shutdown func to flush on exit. Callers should
+// defer the returned func.
+func InitTracer(ctx context.Context, cfg TracingConfig) (func(context.Context) error, error) {
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(cfg.ExporterOTLP), otlptracegrpc.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("create otlp exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx, resource.WithAttributes(semconv.ServiceName(cfg.ServiceName)))
+	if err != nil {
+		return nil, fmt.Errorf("create otel resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.TraceIDRatioBased(cfg.SampleFraction)),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
@@ -0,0 +1,99 @@
+package terminal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/store"
+)
+
+// ErrUnknownCommand is returned for anything outside the allow-list.
+var ErrUnknownCommand = errors.New("unknown command")
+
+const helpText = `available commands: help, whoami, projects, skills, contact, resume, theme <name>, cat <file>`
+
+// Executor runs the fixed, allow-listed set of terminal commands against
+// the portfolio's data. Nothing here shells out or touches the real
+// filesystem — every command is a canned lookup.
+type Executor struct {
+	projects store.ProjectRepo
+	skills   store.SkillRepo
+}
+
+// NewExecutor builds an Executor backed by the given repositories.
+func NewExecutor(projects store.ProjectRepo, skills store.SkillRepo) *Executor {
+	return &Executor{projects: projects, skills: skills}
+}
+
+// Execute runs a single command line and returns its textual output.
+// Unrecognized commands return ErrUnknownCommand.
+func (e *Executor) Execute(line string) (string, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	switch fields[0] {
+	case "help":
+		return helpText, nil
+	case "whoami":
+		return "guest@hackerfolio", nil
+	case "contact":
+		return virtualFS["contact.txt"], nil
+	case "resume":
+		return virtualFS["resume.txt"], nil
+	case "projects":
+		return e.listProjects()
+	case "skills":
+		return e.listSkills()
+	case "theme":
+		return e.theme(fields[1:])
+	case "cat":
+		return e.cat(fields[1:])
+	default:
+		return "", ErrUnknownCommand
+	}
+}
+
+func (e *Executor) listProjects() (string, error) {
+	projects, err := e.projects.List()
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, p := range projects {
+		fmt.Fprintf(&b, "%s — %s\n", p.Name, p.Description)
+	}
+	return strings.TrimRight(b.String(), "\n"), nil
+}
+
+func (e *Executor) listSkills() (string, error) {
+	skills, err := e.skills.List()
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, s := range skills {
+		fmt.Fprintf(&b, "%s (%s)\n", s.Name, s.Category)
+	}
+	return strings.TrimRight(b.String(), "\n"), nil
+}
+
+func (e *Executor) theme(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("usage: theme <name>")
+	}
+	return fmt.Sprintf("theme set to %q", args[0]), nil
+}
+
+func (e *Executor) cat(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("usage: cat <file>")
+	}
+	content, ok := virtualFS[args[0]]
+	if !ok {
+		return "", fmt.Errorf("cat: %s: no such file", args[0])
+	}
+	return content, nil
+}
@@ -0,0 +1,50 @@
+package terminal
+
+import "sync"
+
+// Hub fans out anonymized activity messages ("someone just ran X") to every
+// connected terminal client. It does not own the connections themselves —
+// just a set of per-client outbound channels.
+type Hub struct {
+	mu      sync.Mutex
+	clients map[chan string]struct{}
+}
+
+// NewHub builds an empty Hub.
+func NewHub() *Hub {
+	return &Hub{clients: make(map[chan string]struct{})}
+}
+
+// Join registers a new client and returns its outbound feed. Call Leave
+// when the connection closes.
+func (h *Hub) Join() chan string {
+	ch := make(chan string, 8)
+	h.mu.Lock()
+	h.clients[ch] = struct{}{}
+	h.mu.Unlock()
+	return ch
+}
+
+// Leave unregisters a client and closes its outbound feed.
+func (h *Hub) Leave(ch chan string) {
+	h.mu.Lock()
+	delete(h.clients, ch)
+	h.mu.Unlock()
+	close(ch)
+}
+
+// Broadcast sends msg to every client except the one on exclude, dropping
+// the message for any client whose buffer is full rather than blocking.
+func (h *Hub) Broadcast(msg string, exclude chan string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.clients {
+		if ch == exclude {
+			continue
+		}
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+}
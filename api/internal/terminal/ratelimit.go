@@ -0,0 +1,44 @@
+package terminal
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter is a simple per-connection token bucket, refilled at a fixed
+// rate, so one visitor can't flood the command executor or the broadcast
+// feed.
+type Limiter struct {
+	mu         sync.Mutex
+	tokens     float64
+	max        float64
+	refillRate float64 // tokens per second
+	last       time.Time
+}
+
+// NewLimiter builds a token bucket holding at most max tokens, refilled at
+// refillRate tokens per second.
+func NewLimiter(max float64, refillRate float64) *Limiter {
+	return &Limiter{tokens: max, max: max, refillRate: refillRate, last: time.Now()}
+}
+
+// Allow reports whether another command may run now, consuming a token if
+// so.
+func (l *Limiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(l.last).Seconds()
+	l.last = now
+
+	l.tokens += elapsed * l.refillRate
+	if l.tokens > l.max {
+		l.tokens = l.max
+	}
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
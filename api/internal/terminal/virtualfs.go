@@ -0,0 +1,10 @@
+package terminal
+
+// virtualFS backs the `cat <file>` command with a small set of readable
+// files. It is intentionally static and has no relation to the real
+// filesystem, so the terminal can never be used to read server files.
+var virtualFS = map[string]string{
+	"about.txt":   "Tulio Cunha — full-stack developer building terminal-flavored web experiences.",
+	"contact.txt": "Reach out via the contact form on the portfolio homepage.",
+	"resume.txt":  "Download the resume from the \"resume\" command or the portfolio homepage.",
+}
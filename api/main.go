@@ -1,29 +1,90 @@
 package main
 
 import (
+	"context"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	fiberzap "github.com/gofiber/contrib/fiberzap/v2"
+	otelfiber "github.com/gofiber/contrib/otelfiber/v2"
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/compress"
 	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/gofiber/fiber/v2/middleware/recover"
+
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/apperror"
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/config"
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/handlers"
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/logging"
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/middleware"
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/store"
+	"github.com/tuliopc23/HackerFolio-Tulio/api/internal/telemetry"
 )
 
 func main() {
+	cfg := config.Load()
+
+	zapLogger, err := logging.New(logging.Config{Encoding: cfg.LogEncoding, Level: cfg.LogLevel})
+	if err != nil {
+		log.Fatalf("failed to init logger: %v", err)
+	}
+	defer zapLogger.Sync()
+
+	if err := middleware.InitSentry(middleware.SentryConfig{DSN: cfg.SentryDSN, SampleRate: cfg.SentrySampleRate}); err != nil {
+		log.Fatalf("failed to init sentry: %v", err)
+	}
+
+	shutdownTracing, err := telemetry.InitTracer(context.Background(), telemetry.TracingConfig{
+		ServiceName:    cfg.ServiceName,
+		ExporterOTLP:   cfg.OtelExporterOTLP,
+		SampleFraction: cfg.OtelSampleRatio,
+	})
+	if err != nil {
+		log.Fatalf("failed to init tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	db, err := store.New(cfg.DBPath)
+	if err != nil {
+		log.Fatalf("failed to open store: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Seed(); err != nil {
+		log.Fatalf("failed to seed store: %v", err)
+	}
+
+	cacheStore := middleware.NewCacheStore()
+	h := handlers.New(db.Profiles, db.Projects, db.Skills, db.Terminal, zapLogger, cacheStore)
+
 	app := fiber.New(fiber.Config{
-		Prefork: false,
+		Prefork:      false,
+		ErrorHandler: apperror.Handler(),
 	})
 
 	// Middleware
-	app.Use(logger.New(logger.Config{
-		Format: "[${time}] ${method} ${path} ${status} - ${latency}\n",
+	app.Use(middleware.RequestID())
+	app.Use(fiberzap.New(fiberzap.Config{
+		Logger: zapLogger,
+		Fields: []string{"latency", "status", "method", "path", "ip", "ua", "requestId"},
 	}))
-	
+	app.Use(recover.New())
+	app.Use(middleware.SentryRecover())
+	app.Use(middleware.Breadcrumb())
+
 	app.Use(cors.New(cors.Config{
 		AllowOrigins: "http://localhost:5173,http://localhost:3000",
-		AllowHeaders: "Origin, Content-Type, Accept",
+		AllowHeaders: "Origin, Content-Type, Accept, Authorization",
 	}))
 
+	app.Use(otelfiber.Middleware())
+	telemetry.MountMetrics(app, cfg.ServiceName)
+
+	app.Use(compress.New(compress.Config{Level: compress.LevelBestSpeed}))
+
 	// Health check endpoint
 	app.Get("/api/health", func(c *fiber.Ctx) error {
 		return c.JSON(fiber.Map{
@@ -33,47 +94,42 @@ func main() {
 		})
 	})
 
-	// Portfolio data endpoints
-	app.Get("/api/profile", func(c *fiber.Ctx) error {
-		return c.JSON(fiber.Map{
-			"name":     "Tulio Cunha",
-			"title":    "Full-stack Developer",
-			"location": "Remote",
-			"status":   "Available for projects",
-		})
-	})
-
-	app.Get("/api/projects", func(c *fiber.Ctx) error {
-		projects := []fiber.Map{
-			{
-				"id":          "1",
-				"name":        "Terminal Portfolio",
-				"description": "A vintage CRT-inspired portfolio website with interactive terminal interface.",
-				"stack":       []string{"React", "TypeScript", "Tailwind"},
-				"featured":    true,
-			},
-		}
-		return c.JSON(projects)
-	})
+	// Portfolio data endpoints. Responses are effectively static per deploy,
+	// so GETs are cached and purged by the admin CRUD handlers below.
+	app.Get("/api/profile", middleware.ETag(), middleware.CachedReadOnly(cacheStore, cfg.CacheTTL), h.GetProfile)
+	app.Get("/api/projects", middleware.ETag(), middleware.CachedReadOnly(cacheStore, cfg.CacheTTL), h.ListProjects)
 
 	// Terminal command logging endpoint
-	app.Post("/api/terminal/log", func(c *fiber.Ctx) error {
-		var body struct {
-			Command   string    `json:"command"`
-			Timestamp time.Time `json:"timestamp"`
-		}
-		
-		if err := c.BodyParser(&body); err != nil {
-			return c.Status(400).JSON(fiber.Map{"error": "Invalid request body"})
+	app.Post("/api/terminal/log", h.LogTerminalCommand)
+
+	// Live terminal session: allow-listed commands, streamed responses, and
+	// an anonymized cross-visitor activity feed.
+	app.Use("/ws/terminal", handlers.RequireWebSocketUpgrade)
+	app.Get("/ws/terminal", h.TerminalWS())
+
+	// Admin endpoints, protected by JWT, let the site owner edit portfolio
+	// content without a redeploy.
+	admin := app.Group("/api", middleware.AdminAuth(cfg.JWTSecret))
+	admin.Put("/profile", h.UpdateProfile)
+	admin.Post("/projects", h.CreateProject)
+	admin.Put("/projects/:id", h.UpdateProject)
+	admin.Delete("/projects/:id", h.DeleteProject)
+
+	go func() {
+		log.Printf("Fiber server starting on port %s\n", cfg.Port)
+		if err := app.Listen(":" + cfg.Port); err != nil {
+			log.Printf("server stopped: %v", err)
 		}
-		
-		log.Printf("Terminal command: %s at %v\n", body.Command, body.Timestamp)
-		return c.JSON(fiber.Map{"logged": true})
-	})
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
 
-	port := "8080"
-	log.Printf("Fiber server starting on port %s\n", port)
-	log.Fatal(app.Listen(":" + port))
+	log.Println("shutting down gracefully...")
+	if err := app.ShutdownWithTimeout(10 * time.Second); err != nil {
+		log.Printf("graceful shutdown failed: %v", err)
+	}
 }
 
-var startTime = time.Now()
\ No newline at end of file
+var startTime = time.Now()